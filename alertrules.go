@@ -0,0 +1,219 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// provenanceDisableHeader, when set to "true", lets rules, contact points,
+// and policies created through the API remain editable from the UI
+// instead of being locked as provisioned-by-file.
+const provenanceDisableHeader = "X-Disable-Provenance"
+
+// AlertRule represents a Grafana-managed unified alerting rule, as served
+// by the /api/v1/provisioning/alert-rules endpoints.
+type AlertRule struct {
+	UID          string            `json:"uid,omitempty"`
+	Title        string            `json:"title"`
+	Condition    string            `json:"condition"`
+	Data         []AlertQuery      `json:"data"`
+	NoDataState  string            `json:"noDataState"`
+	ExecErrState string            `json:"execErrState"`
+	For          string            `json:"for"`
+	FolderUID    string            `json:"folderUID"`
+	RuleGroup    string            `json:"ruleGroup"`
+	OrgID        int64             `json:"orgID"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// AlertQuery is one entry in an AlertRule's Data field: a datasource
+// query or expression evaluated as part of the rule.
+type AlertQuery struct {
+	RefID             string                 `json:"refId"`
+	DatasourceUID     string                 `json:"datasourceUid"`
+	Model             map[string]interface{} `json:"model"`
+	RelativeTimeRange AlertRuleTimeRange     `json:"relativeTimeRange"`
+}
+
+// AlertRuleTimeRange bounds how far back an AlertQuery evaluates.
+type AlertRuleTimeRange struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// AlertRuleGroup is a named group of AlertRules that share an evaluation
+// interval, as served by /api/v1/provisioning/folder/:folderUID/rule-groups/:group.
+type AlertRuleGroup struct {
+	Title     string      `json:"title"`
+	FolderUID string      `json:"folderUid"`
+	Interval  int64       `json:"interval"`
+	Rules     []AlertRule `json:"rules"`
+}
+
+func headersForProvenance(disableProvenance bool) map[string]string {
+	if !disableProvenance {
+		return nil
+	}
+	return map[string]string{provenanceDisableHeader: "true"}
+}
+
+// AlertRules returns every provisioned alert rule.
+func (c *Client) AlertRules() ([]AlertRule, error) {
+	return c.AlertRulesWithContext(context.Background())
+}
+
+// AlertRulesWithContext returns every provisioned alert rule, aborting the
+// request if ctx is canceled.
+func (c *Client) AlertRulesWithContext(ctx context.Context) ([]AlertRule, error) {
+	result := make([]AlertRule, 0)
+
+	res, err := c.doRequestCtx(ctx, "GET", "/api/v1/provisioning/alert-rules", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
+
+// AlertRule fetches a single provisioned alert rule by uid.
+func (c *Client) AlertRule(uid string) (*AlertRule, error) {
+	return c.AlertRuleWithContext(context.Background(), uid)
+}
+
+// AlertRuleWithContext fetches a single provisioned alert rule by uid,
+// aborting the request if ctx is canceled.
+func (c *Client) AlertRuleWithContext(ctx context.Context, uid string) (*AlertRule, error) {
+	path := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", uid)
+	res, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	rule := &AlertRule{}
+	err = res.BindJSON(rule)
+	return rule, err
+}
+
+// CreateAlertRule creates rule. When disableProvenance is true, the rule
+// remains editable from the Grafana UI afterwards.
+func (c *Client) CreateAlertRule(rule AlertRule, disableProvenance bool) (*AlertRule, error) {
+	return c.CreateAlertRuleWithContext(context.Background(), rule, disableProvenance)
+}
+
+// CreateAlertRuleWithContext creates rule, aborting the request if ctx is
+// canceled.
+func (c *Client) CreateAlertRuleWithContext(ctx context.Context, rule AlertRule, disableProvenance bool) (*AlertRule, error) {
+	res, err := c.doJSONRequestCtxHeaders(ctx, "POST", "/api/v1/provisioning/alert-rules", rule, headersForProvenance(disableProvenance))
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	created := &AlertRule{}
+	err = res.BindJSON(created)
+	return created, err
+}
+
+// UpdateAlertRule updates the alert rule identified by rule.UID.
+func (c *Client) UpdateAlertRule(rule AlertRule, disableProvenance bool) (*AlertRule, error) {
+	return c.UpdateAlertRuleWithContext(context.Background(), rule, disableProvenance)
+}
+
+// UpdateAlertRuleWithContext updates the alert rule identified by
+// rule.UID, aborting the request if ctx is canceled.
+func (c *Client) UpdateAlertRuleWithContext(ctx context.Context, rule AlertRule, disableProvenance bool) (*AlertRule, error) {
+	path := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", rule.UID)
+	res, err := c.doJSONRequestCtxHeaders(ctx, "PUT", path, rule, headersForProvenance(disableProvenance))
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	updated := &AlertRule{}
+	err = res.BindJSON(updated)
+	return updated, err
+}
+
+// DeleteAlertRule deletes the alert rule identified by uid.
+func (c *Client) DeleteAlertRule(uid string) error {
+	return c.DeleteAlertRuleWithContext(context.Background(), uid)
+}
+
+// DeleteAlertRuleWithContext deletes the alert rule identified by uid,
+// aborting the request if ctx is canceled.
+func (c *Client) DeleteAlertRuleWithContext(ctx context.Context, uid string) error {
+	path := fmt.Sprintf("/api/v1/provisioning/alert-rules/%s", uid)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
+
+// AlertRuleGroup fetches the named rule group from folderUID.
+func (c *Client) AlertRuleGroup(folderUID, group string) (*AlertRuleGroup, error) {
+	return c.AlertRuleGroupWithContext(context.Background(), folderUID, group)
+}
+
+// AlertRuleGroupWithContext fetches the named rule group from folderUID,
+// aborting the request if ctx is canceled.
+func (c *Client) AlertRuleGroupWithContext(ctx context.Context, folderUID, group string) (*AlertRuleGroup, error) {
+	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, group)
+	res, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	ruleGroup := &AlertRuleGroup{}
+	err = res.BindJSON(ruleGroup)
+	return ruleGroup, err
+}
+
+// SetAlertRuleGroup creates or replaces the named rule group in
+// folderUID.
+func (c *Client) SetAlertRuleGroup(folderUID string, group AlertRuleGroup, disableProvenance bool) (*AlertRuleGroup, error) {
+	return c.SetAlertRuleGroupWithContext(context.Background(), folderUID, group, disableProvenance)
+}
+
+// SetAlertRuleGroupWithContext creates or replaces the named rule group in
+// folderUID, aborting the request if ctx is canceled.
+func (c *Client) SetAlertRuleGroupWithContext(ctx context.Context, folderUID string, group AlertRuleGroup, disableProvenance bool) (*AlertRuleGroup, error) {
+	path := fmt.Sprintf("/api/v1/provisioning/folder/%s/rule-groups/%s", folderUID, group.Title)
+	res, err := c.doJSONRequestCtxHeaders(ctx, "PUT", path, group, headersForProvenance(disableProvenance))
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	updated := &AlertRuleGroup{}
+	err = res.BindJSON(updated)
+	return updated, err
+}
@@ -1,6 +1,7 @@
 package gapi
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,10 +17,16 @@ type AlertSummary struct {
 
 // Alerts returns an array of AlertSummary objects
 func (c *Client) Alerts() ([]AlertSummary, error) {
+	return c.AlertsWithContext(context.Background())
+}
+
+// AlertsWithContext returns an array of AlertSummary objects, aborting the
+// request if ctx is canceled.
+func (c *Client) AlertsWithContext(ctx context.Context) ([]AlertSummary, error) {
 	result := make([]AlertSummary, 0)
 
 	path := fmt.Sprintf("/api/alerts")
-	res, err := c.doRequest("GET", path, nil)
+	res, err := c.doRequestCtx(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
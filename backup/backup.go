@@ -0,0 +1,140 @@
+// Package backup snapshots a Grafana instance's dashboards, folders, and
+// datasources to a directory tree of normalized JSON files, optionally
+// committing the snapshot to a Git repository.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	gapi "github.com/Ezetowers/go-grafana-api"
+)
+
+const (
+	dashboardsDir  = "dashboards"
+	foldersDir     = "folders"
+	dataSourcesDir = "datasources"
+	generalFolder  = "general"
+)
+
+// Config controls where Backup writes its snapshot and how it is
+// committed to version control.
+type Config struct {
+	// Dir is the root directory the snapshot is written to. It is created
+	// if it does not already exist.
+	Dir string
+
+	// Git, if set, is used to commit the snapshot once it has been
+	// written.
+	Git GitDriver
+}
+
+// Backup walks every dashboard, folder, and datasource on client and
+// writes a normalized JSON snapshot to cfg.Dir.
+func Backup(ctx context.Context, client *gapi.Client, cfg Config) error {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return err
+	}
+
+	if err := backupDataSources(ctx, client, cfg.Dir); err != nil {
+		return err
+	}
+
+	folderByID, err := backupFolders(ctx, client, cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	if err := backupDashboards(ctx, client, cfg.Dir, folderByID); err != nil {
+		return err
+	}
+
+	if cfg.Git != nil {
+		return cfg.Git.Commit(cfg.Dir)
+	}
+
+	return nil
+}
+
+func backupDataSources(ctx context.Context, client *gapi.Client, dir string) error {
+	dataSources, err := client.DataSourcesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	dsDir := filepath.Join(dir, dataSourcesDir)
+	if err := os.MkdirAll(dsDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, ds := range dataSources {
+		if err := writeJSON(filepath.Join(dsDir, ds.Name+".json"), ds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func backupFolders(ctx context.Context, client *gapi.Client, dir string) (map[int64]gapi.Folder, error) {
+	folders, err := client.FoldersWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fDir := filepath.Join(dir, foldersDir)
+	if err := os.MkdirAll(fDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	folderByID := make(map[int64]gapi.Folder, len(folders))
+	for _, f := range folders {
+		if err := writeJSON(filepath.Join(fDir, f.UID+".json"), f); err != nil {
+			return nil, err
+		}
+		folderByID[f.ID] = f
+	}
+
+	return folderByID, nil
+}
+
+func backupDashboards(ctx context.Context, client *gapi.Client, dir string, folderByID map[int64]gapi.Folder) error {
+	it := client.NewSearchIterator(ctx, url.Values{"type": []string{"dash-db"}})
+	for it.Next() {
+		hit := it.Value()
+
+		dashboard, err := client.DashboardByUIDWithContext(ctx, hit.UID)
+		if err != nil {
+			return err
+		}
+
+		folderDir := generalFolder
+		if f, ok := folderByID[hit.FolderID]; ok {
+			folderDir = f.UID
+		}
+
+		destDir := filepath.Join(dir, dashboardsDir, folderDir)
+		if err := os.MkdirAll(destDir, 0o755); err != nil {
+			return err
+		}
+
+		if err := writeJSON(filepath.Join(destDir, hit.UID+".json"), dashboard); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// GitDriver commits a snapshot directory written by Backup to version
+// control.
+type GitDriver interface {
+	Commit(dir string) error
+}
+
+// GoGitDriver commits snapshots to a local Git repository using go-git. It
+// expects dir to already be (or be inside) a Git working tree, e.g. one
+// created with `git init` ahead of time.
+type GoGitDriver struct {
+	AuthorName  string
+	AuthorEmail string
+	Message     string
+	SigningKey  *openpgp.Entity
+}
+
+// NewGoGitDriver creates a GoGitDriver that commits as authorName
+// <authorEmail>. Use the With* options to customize the commit message or
+// sign commits with a GPG key.
+func NewGoGitDriver(authorName, authorEmail string, opts ...GoGitDriverOption) *GoGitDriver {
+	d := &GoGitDriver{
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Message:     "grafana: snapshot",
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// GoGitDriverOption configures a GoGitDriver.
+type GoGitDriverOption func(*GoGitDriver)
+
+// WithCommitMessage overrides the default commit message.
+func WithCommitMessage(msg string) GoGitDriverOption {
+	return func(d *GoGitDriver) {
+		d.Message = msg
+	}
+}
+
+// WithSigningKey causes commits to be GPG-signed with key.
+func WithSigningKey(key *openpgp.Entity) GoGitDriverOption {
+	return func(d *GoGitDriver) {
+		d.SigningKey = key
+	}
+}
+
+// Commit stages every change under dir and commits it to the repository
+// dir belongs to. dir may be the worktree root or a subdirectory of it;
+// only changes under dir are staged, so other dirty files elsewhere in
+// the worktree are left untouched. It is a no-op if there is nothing to
+// commit.
+func (d *GoGitDriver) Commit(dir string) error {
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+
+	relDir, err := filepath.Rel(wt.Filesystem.Root(), absDir)
+	if err != nil {
+		return err
+	}
+
+	if _, err := wt.Add(relDir); err != nil {
+		return err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return err
+	}
+	if !anyStaged(status, relDir) {
+		return nil
+	}
+
+	_, err = wt.Commit(d.Message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  d.AuthorName,
+			Email: d.AuthorEmail,
+			When:  time.Now(),
+		},
+		SignKey: d.SigningKey,
+	})
+	return err
+}
+
+// anyStaged reports whether status contains any staged change under
+// relDir (relDir being "." matches everything).
+func anyStaged(status git.Status, relDir string) bool {
+	for path, s := range status {
+		if s.Staging == git.Unmodified {
+			continue
+		}
+		if relDir == "." || path == relDir || strings.HasPrefix(path, relDir+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	gapi "github.com/Ezetowers/go-grafana-api"
+)
+
+// Restore reads a snapshot previously written by Backup from dir, creates
+// any of its folders that are missing on client (existing folders are left
+// unchanged), and upserts its dashboards, overwriting any existing
+// dashboard with the same UID.
+func Restore(ctx context.Context, client *gapi.Client, dir string) error {
+	if err := restoreFolders(ctx, client, filepath.Join(dir, foldersDir)); err != nil {
+		return err
+	}
+
+	return restoreDashboards(ctx, client, filepath.Join(dir, dashboardsDir))
+}
+
+func restoreFolders(ctx context.Context, client *gapi.Client, dir string) error {
+	return walkJSONFiles(dir, func(path string) error {
+		var folder gapi.Folder
+		if err := readJSON(path, &folder); err != nil {
+			return err
+		}
+
+		if _, err := client.FolderByUIDWithContext(ctx, folder.UID); err == nil || !gapi.IsNotFound(err) {
+			return err
+		}
+
+		_, err := client.CreateFolderWithUIDWithContext(ctx, folder.Title, folder.UID)
+		return err
+	})
+}
+
+func restoreDashboards(ctx context.Context, client *gapi.Client, dir string) error {
+	return walkJSONFiles(dir, func(path string) error {
+		var dashboard gapi.Dashboard
+		if err := readJSON(path, &dashboard); err != nil {
+			return err
+		}
+
+		// The snapshot's folder placement only lives in Meta.FolderUID
+		// (that's all DashboardByUIDWithContext populates); carry it over
+		// to the top-level field SaveDashboardWithContext reads.
+		dashboard.FolderUID = dashboard.Meta.FolderUID
+		dashboard.Overwrite = true
+		_, err := client.SaveDashboardWithContext(ctx, dashboard)
+		return err
+	})
+}
+
+func walkJSONFiles(dir string, fn func(path string) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+
+		return fn(path)
+	})
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
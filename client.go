@@ -2,16 +2,20 @@ package gapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var ErrNotFound = errors.New(http.StatusText(404))
@@ -23,13 +27,80 @@ type Client struct {
 	bearerAuth     string
 	basicAuth      string
 	baseURL        url.URL
+	userAgent      string
+	retry          RetryPolicy
+	limiter        *rateLimiter
 	LastStatusCode int
 	*http.Client
 }
 
+// ClientOption configures a Client at construction time.
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the underlying *http.Client used to perform requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.Client = hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithToken overrides the bearer token used for authentication.
+func WithToken(token string) ClientOption {
+	return func(c *Client) {
+		c.bearerAuth = fmt.Sprintf("Bearer %s", token)
+		c.basicAuth = ""
+	}
+}
+
+// WithTimeout sets the timeout of the underlying *http.Client.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.Client.Timeout = timeout
+	}
+}
+
+// WithRetry configures the retry policy used for 429 and 5xx responses.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithRateLimit caps outgoing requests to rps requests per second, allowing
+// bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// RetryPolicy controls how doRequestCtx retries requests that fail with a
+// 429 or 5xx response.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used when no RetryPolicy is supplied via WithRetry.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 0,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
 // New creates a new grafana client
 // auth can be in user:pass format, or it can be an api key
-func New(auth, baseURL string) (*Client, error) {
+func New(auth, baseURL string, opts ...ClientOption) (*Client, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
@@ -38,10 +109,15 @@ func New(auth, baseURL string) (*Client, error) {
 	c := &Client{
 		Client:  &http.Client{},
 		baseURL: *u,
+		retry:   DefaultRetryPolicy(),
 	}
 
 	c.parseAuth(auth)
 
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c, nil
 }
 
@@ -72,8 +148,12 @@ func (c *Client) jsonRequest(method, requestPath string, v interface{}) (*http.R
 }
 
 func (c *Client) newRequest(method, requestPath string, body io.Reader) (*http.Request, error) {
+	return c.newRequestCtx(context.Background(), method, requestPath, body)
+}
+
+func (c *Client) newRequestCtx(ctx context.Context, method, requestPath string, body io.Reader) (*http.Request, error) {
 	url := c.baseURL.String() + requestPath
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return req, err
 	}
@@ -88,27 +168,159 @@ func (c *Client) newRequest(method, requestPath string, body io.Reader) (*http.R
 
 	req.Header.Add("Content-Type", "application/json")
 
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
 	logRequest(req)
 
 	return req, err
 }
 
 func (c *Client) doRequest(method, requestPath string, body io.Reader) (*Response, error) {
-	req, err := c.newRequest(method, requestPath, body)
+	return c.doRequestCtx(context.Background(), method, requestPath, body)
+}
+
+func (c *Client) doJSONRequest(method, requestPath string, v interface{}) (*Response, error) {
+	return c.doJSONRequestCtx(context.Background(), method, requestPath, v)
+}
+
+// doRequestCtx performs an HTTP request, honoring ctx cancellation and
+// retrying 429/5xx responses according to the client's RetryPolicy.
+func (c *Client) doRequestCtx(ctx context.Context, method, requestPath string, body io.Reader) (*Response, error) {
+	return c.doRequestCtxHeaders(ctx, method, requestPath, body, nil)
+}
+
+// doRequestCtxHeaders behaves like doRequestCtx but sets the given extra
+// headers on every attempt, e.g. the X-Disable-Provenance header used by
+// the unified alerting provisioning endpoints.
+func (c *Client) doRequestCtxHeaders(ctx context.Context, method, requestPath string, body io.Reader, headers map[string]string) (*Response, error) {
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res *Response
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := c.newRequestCtx(ctx, method, requestPath, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		httpRes, err := c.Do(req)
+		res = NewResponse(httpRes, err)
+
+		if err == nil {
+			c.LastStatusCode = res.StatusCode
+		}
+
+		if !c.shouldRetry(res, attempt) {
+			return res, nil
+		}
+
+		if err := sleepWithContext(ctx, c.nextBackoff(res, attempt)); err != nil {
+			return res, err
+		}
+	}
+}
+
+func (c *Client) doJSONRequestCtx(ctx context.Context, method, requestPath string, v interface{}) (*Response, error) {
+	data, err := json.Marshal(v)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewResponse(c.Do(req)), nil
+	return c.doRequestCtx(ctx, method, requestPath, bytes.NewBuffer(data))
 }
 
-func (c *Client) doJSONRequest(method, requestPath string, v interface{}) (*Response, error) {
-	req, err := c.jsonRequest(method, requestPath, v)
+// doJSONRequestCtxHeaders behaves like doJSONRequestCtx but sets the given
+// extra headers on the request.
+func (c *Client) doJSONRequestCtxHeaders(ctx context.Context, method, requestPath string, v interface{}, headers map[string]string) (*Response, error) {
+	data, err := json.Marshal(v)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewResponse(c.Do(req)), nil
+	return c.doRequestCtxHeaders(ctx, method, requestPath, bytes.NewBuffer(data), headers)
+}
+
+// shouldRetry reports whether res warrants another attempt given attempt,
+// the zero-based index of the attempt that produced res (i.e. the number
+// of retries already made).
+func (c *Client) shouldRetry(res *Response, attempt int) bool {
+	if res == nil || res.err != nil {
+		return false
+	}
+
+	if attempt >= c.retry.MaxRetries {
+		return false
+	}
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode >= 500:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextBackoff computes how long to wait before the next attempt, honoring a
+// Retry-After header when present and otherwise using exponential backoff
+// with jitter.
+func (c *Client) nextBackoff(res *Response, attempt int) time.Duration {
+	if res != nil {
+		if ra := res.Header.Get("Retry-After"); ra != "" {
+			if secs, err := strconv.Atoi(ra); err == nil {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	backoff := c.retry.MinBackoff * time.Duration(1<<uint(attempt))
+	if backoff > c.retry.MaxBackoff || backoff <= 0 {
+		backoff = c.retry.MaxBackoff
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+	return backoff/2 + jitter/2
+}
+
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.Wait(ctx)
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 func logRequest(req *http.Request) {
@@ -152,7 +364,7 @@ func logResponse(res *http.Response) {
 func NewResponse(res *http.Response, rerr error) *Response {
 	var data []byte
 
-	if res.Body != nil {
+	if rerr == nil && res.Body != nil {
 		data, _ = ioutil.ReadAll(res.Body)
 	}
 
@@ -190,14 +402,9 @@ func (res *Response) Error() error {
 		return res.err
 	}
 
-	switch res.StatusCode {
-	case 200:
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
 		return nil
-	case 404:
-		return ErrNotFound
-	case 409:
-		return ErrConflict
-	default:
-		return fmt.Errorf(res.Status)
 	}
+
+	return newAPIError(res.StatusCode, res.Status, res.data)
 }
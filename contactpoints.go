@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// ContactPoint represents a unified alerting notifier (Slack, email,
+// webhook, ...), as served by /api/v1/provisioning/contact-points.
+type ContactPoint struct {
+	UID                   string                 `json:"uid,omitempty"`
+	Name                  string                 `json:"name"`
+	Type                  string                 `json:"type"`
+	Settings              map[string]interface{} `json:"settings"`
+	DisableResolveMessage bool                   `json:"disableResolveMessage,omitempty"`
+}
+
+// ContactPoints returns every provisioned contact point.
+func (c *Client) ContactPoints() ([]ContactPoint, error) {
+	return c.ContactPointsWithContext(context.Background())
+}
+
+// ContactPointsWithContext returns every provisioned contact point,
+// aborting the request if ctx is canceled.
+func (c *Client) ContactPointsWithContext(ctx context.Context) ([]ContactPoint, error) {
+	result := make([]ContactPoint, 0)
+
+	res, err := c.doRequestCtx(ctx, "GET", "/api/v1/provisioning/contact-points", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
+
+// CreateContactPoint creates cp. When disableProvenance is true, the
+// contact point remains editable from the Grafana UI afterwards.
+func (c *Client) CreateContactPoint(cp ContactPoint, disableProvenance bool) (*ContactPoint, error) {
+	return c.CreateContactPointWithContext(context.Background(), cp, disableProvenance)
+}
+
+// CreateContactPointWithContext creates cp, aborting the request if ctx is
+// canceled.
+func (c *Client) CreateContactPointWithContext(ctx context.Context, cp ContactPoint, disableProvenance bool) (*ContactPoint, error) {
+	res, err := c.doJSONRequestCtxHeaders(ctx, "POST", "/api/v1/provisioning/contact-points", cp, headersForProvenance(disableProvenance))
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	created := &ContactPoint{}
+	err = res.BindJSON(created)
+	return created, err
+}
+
+// UpdateContactPoint updates the contact point identified by cp.UID.
+func (c *Client) UpdateContactPoint(cp ContactPoint, disableProvenance bool) error {
+	return c.UpdateContactPointWithContext(context.Background(), cp, disableProvenance)
+}
+
+// UpdateContactPointWithContext updates the contact point identified by
+// cp.UID, aborting the request if ctx is canceled.
+func (c *Client) UpdateContactPointWithContext(ctx context.Context, cp ContactPoint, disableProvenance bool) error {
+	path := fmt.Sprintf("/api/v1/provisioning/contact-points/%s", cp.UID)
+	res, err := c.doJSONRequestCtxHeaders(ctx, "PUT", path, cp, headersForProvenance(disableProvenance))
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
+
+// DeleteContactPoint deletes the contact point identified by uid.
+func (c *Client) DeleteContactPoint(uid string) error {
+	return c.DeleteContactPointWithContext(context.Background(), uid)
+}
+
+// DeleteContactPointWithContext deletes the contact point identified by
+// uid, aborting the request if ctx is canceled.
+func (c *Client) DeleteContactPointWithContext(ctx context.Context, uid string) error {
+	path := fmt.Sprintf("/api/v1/provisioning/contact-points/%s", uid)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
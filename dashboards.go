@@ -0,0 +1,103 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// Dashboard represents a Grafana dashboard and the folder/overwrite
+// metadata needed to save it back via the API.
+type Dashboard struct {
+	Meta      DashboardMeta          `json:"meta"`
+	Model     map[string]interface{} `json:"dashboard"`
+	FolderID  int64                  `json:"folderId,omitempty"`
+	FolderUID string                 `json:"folderUid,omitempty"`
+	Overwrite bool                   `json:"overwrite,omitempty"`
+}
+
+// DashboardMeta holds the metadata Grafana returns alongside a dashboard's
+// JSON model.
+type DashboardMeta struct {
+	IsStarred bool   `json:"isStarred,omitempty"`
+	Slug      string `json:"slug"`
+	FolderID  int64  `json:"folderId"`
+	FolderUID string `json:"folderUid"`
+	URL       string `json:"url"`
+	Version   int64  `json:"version"`
+}
+
+// DashboardSaveResponse is returned by the dashboard save endpoint.
+type DashboardSaveResponse struct {
+	ID      int64  `json:"id"`
+	UID     string `json:"uid"`
+	Slug    string `json:"slug"`
+	Status  string `json:"status"`
+	Version int64  `json:"version"`
+}
+
+// DashboardByUID fetches a single dashboard by its UID.
+func (c *Client) DashboardByUID(uid string) (*Dashboard, error) {
+	return c.DashboardByUIDWithContext(context.Background(), uid)
+}
+
+// DashboardByUIDWithContext fetches a single dashboard by its UID, aborting
+// the request if ctx is canceled.
+func (c *Client) DashboardByUIDWithContext(ctx context.Context, uid string) (*Dashboard, error) {
+	path := fmt.Sprintf("/api/dashboards/uid/%s", uid)
+	res, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	dashboard := &Dashboard{}
+	err = res.BindJSON(dashboard)
+	return dashboard, err
+}
+
+// SaveDashboard creates or updates dashboard, depending on whether
+// dashboard.Overwrite is set.
+func (c *Client) SaveDashboard(dashboard Dashboard) (*DashboardSaveResponse, error) {
+	return c.SaveDashboardWithContext(context.Background(), dashboard)
+}
+
+// SaveDashboardWithContext creates or updates dashboard, aborting the
+// request if ctx is canceled.
+func (c *Client) SaveDashboardWithContext(ctx context.Context, dashboard Dashboard) (*DashboardSaveResponse, error) {
+	res, err := c.doJSONRequestCtx(ctx, "POST", "/api/dashboards/db", dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	saveResp := &DashboardSaveResponse{}
+	err = res.BindJSON(saveResp)
+	return saveResp, err
+}
+
+// DeleteDashboard deletes the dashboard identified by uid.
+func (c *Client) DeleteDashboard(uid string) error {
+	return c.DeleteDashboardWithContext(context.Background(), uid)
+}
+
+// DeleteDashboardWithContext deletes the dashboard identified by uid,
+// aborting the request if ctx is canceled.
+func (c *Client) DeleteDashboardWithContext(ctx context.Context, uid string) error {
+	path := fmt.Sprintf("/api/dashboards/uid/%s", uid)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
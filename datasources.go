@@ -0,0 +1,106 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// DataSource represents a Grafana datasource.
+type DataSource struct {
+	ID        int64                  `json:"id,omitempty"`
+	UID       string                 `json:"uid,omitempty"`
+	Name      string                 `json:"name"`
+	Type      string                 `json:"type"`
+	URL       string                 `json:"url"`
+	Access    string                 `json:"access"`
+	BasicAuth bool                   `json:"basicAuth"`
+	IsDefault bool                   `json:"isDefault"`
+	JSONData  map[string]interface{} `json:"jsonData,omitempty"`
+}
+
+// DataSources returns all configured datasources.
+func (c *Client) DataSources() ([]DataSource, error) {
+	return c.DataSourcesWithContext(context.Background())
+}
+
+// DataSourcesWithContext returns all configured datasources, aborting the
+// request if ctx is canceled.
+func (c *Client) DataSourcesWithContext(ctx context.Context) ([]DataSource, error) {
+	result := make([]DataSource, 0)
+
+	res, err := c.doRequestCtx(ctx, "GET", "/api/datasources", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
+
+// CreateDataSource creates a new datasource.
+func (c *Client) CreateDataSource(ds DataSource) (*DataSource, error) {
+	return c.CreateDataSourceWithContext(context.Background(), ds)
+}
+
+// CreateDataSourceWithContext creates a new datasource, aborting the
+// request if ctx is canceled.
+func (c *Client) CreateDataSourceWithContext(ctx context.Context, ds DataSource) (*DataSource, error) {
+	res, err := c.doJSONRequestCtx(ctx, "POST", "/api/datasources", ds)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	created := &DataSource{}
+	err = res.BindJSON(created)
+	return created, err
+}
+
+// UpdateDataSource updates the datasource identified by ds.ID.
+func (c *Client) UpdateDataSource(ds DataSource) error {
+	return c.UpdateDataSourceWithContext(context.Background(), ds)
+}
+
+// UpdateDataSourceWithContext updates the datasource identified by
+// ds.ID, aborting the request if ctx is canceled.
+func (c *Client) UpdateDataSourceWithContext(ctx context.Context, ds DataSource) error {
+	path := fmt.Sprintf("/api/datasources/%d", ds.ID)
+	res, err := c.doJSONRequestCtx(ctx, "PUT", path, ds)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
+
+// DeleteDataSource deletes the datasource identified by id.
+func (c *Client) DeleteDataSource(id int64) error {
+	return c.DeleteDataSourceWithContext(context.Background(), id)
+}
+
+// DeleteDataSourceWithContext deletes the datasource identified by id,
+// aborting the request if ctx is canceled.
+func (c *Client) DeleteDataSourceWithContext(ctx context.Context, id int64) error {
+	path := fmt.Sprintf("/api/datasources/%d", id)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matched by APIError's Is method. Use errors.Is(err,
+// ErrUnauthorized) and friends (or the Is* helpers below) to branch on the
+// kind of failure without inspecting an *APIError directly.
+var (
+	ErrUnauthorized = errors.New(http.StatusText(http.StatusUnauthorized))
+	ErrForbidden    = errors.New(http.StatusText(http.StatusForbidden))
+	ErrRateLimited  = errors.New(http.StatusText(http.StatusTooManyRequests))
+	ErrServerError  = errors.New("grafana server error")
+)
+
+// APIError is returned whenever the Grafana API responds with a non-2xx
+// status code. It decodes Grafana's `{"message": "..."}` error envelope so
+// callers can distinguish, say, a dashboard version mismatch from a generic
+// 409, while still keeping the raw response body around for debugging.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Message    string
+	TraceID    string
+	Body       []byte
+}
+
+func newAPIError(statusCode int, status string, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: statusCode,
+		Status:     status,
+		Body:       body,
+	}
+
+	var envelope struct {
+		Message string `json:"message"`
+		TraceID string `json:"traceID"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		e.Message = envelope.Message
+		e.TraceID = envelope.TraceID
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Message)
+	}
+	return e.Status
+}
+
+// Is allows errors.Is(err, ErrNotFound) and friends to work against an
+// *APIError without callers needing to compare StatusCode themselves.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500
+	default:
+		return false
+	}
+}
+
+// IsNotFound reports whether err represents a 404 response.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsConflict reports whether err represents a 409 response.
+func IsConflict(err error) bool { return errors.Is(err, ErrConflict) }
+
+// IsUnauthorized reports whether err represents a 401 response.
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+
+// IsForbidden reports whether err represents a 403 response.
+func IsForbidden(err error) bool { return errors.Is(err, ErrForbidden) }
+
+// IsRateLimited reports whether err represents a 429 response.
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
+
+// IsServerError reports whether err represents a 5xx response.
+func IsServerError(err error) bool { return errors.Is(err, ErrServerError) }
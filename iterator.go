@@ -0,0 +1,137 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AlertIterator yields AlertSummary results one at a time, decoding them
+// element-by-element instead of building a slice of every alert as Alerts
+// does. The response body itself is still read into memory in full before
+// decoding starts.
+//
+// The legacy /api/alerts endpoint this iterates has no offset/page
+// parameter, so there is only ever one "page": up to PageSize alerts,
+// limited by the `limit` query param. AlertIterator does not fetch
+// further pages beyond that; use WithAlertPageSize to raise the cap.
+type AlertIterator struct {
+	client      *Client
+	ctx         context.Context
+	dashboardID int64
+	pageSize    int
+	fetched     bool
+	dec         *json.Decoder
+	cur         AlertSummary
+	err         error
+	exhausted   bool
+}
+
+// AlertIteratorOption configures a NewAlertIterator call.
+type AlertIteratorOption func(*AlertIterator)
+
+// WithAlertDashboardID restricts the iterator to alerts belonging to
+// dashboardID.
+func WithAlertDashboardID(dashboardID int64) AlertIteratorOption {
+	return func(it *AlertIterator) {
+		it.dashboardID = dashboardID
+	}
+}
+
+// WithAlertPageSize overrides the default limit passed to /api/alerts.
+func WithAlertPageSize(pageSize int) AlertIteratorOption {
+	return func(it *AlertIterator) {
+		it.pageSize = pageSize
+	}
+}
+
+// NewAlertIterator returns an AlertIterator over /api/alerts.
+func (c *Client) NewAlertIterator(ctx context.Context, opts ...AlertIteratorOption) *AlertIterator {
+	it := &AlertIterator{
+		client:   c,
+		ctx:      ctx,
+		pageSize: 100,
+	}
+
+	for _, opt := range opts {
+		opt(it)
+	}
+
+	return it
+}
+
+// Next advances the iterator and reports whether a value is available via
+// Value. It returns false once the result set is exhausted or an error
+// occurs; use Err to distinguish the two.
+func (it *AlertIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for {
+		if it.dec != nil && it.dec.More() {
+			if err := it.dec.Decode(&it.cur); err != nil {
+				it.err = err
+				return false
+			}
+			return true
+		}
+
+		if it.exhausted {
+			return false
+		}
+
+		if !it.fetchPage() {
+			return false
+		}
+	}
+}
+
+func (it *AlertIterator) fetchPage() bool {
+	if it.fetched {
+		it.exhausted = true
+		return false
+	}
+	it.fetched = true
+
+	path := fmt.Sprintf("/api/alerts?limit=%d", it.pageSize)
+	if it.dashboardID != 0 {
+		path += fmt.Sprintf("&dashboardId=%d", it.dashboardID)
+	}
+
+	res, err := it.client.doRequestCtx(it.ctx, "GET", path, nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if !res.OK() {
+		it.err = res.Error()
+		return false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(res.data))
+	if _, err := dec.Token(); err != nil { // consume the opening '['
+		it.err = err
+		return false
+	}
+
+	if !dec.More() {
+		it.exhausted = true
+		return false
+	}
+
+	it.dec = dec
+	return true
+}
+
+// Value returns the alert most recently produced by Next.
+func (it *AlertIterator) Value() AlertSummary {
+	return it.cur
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *AlertIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,112 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAlertIteratorFetchesExactlyOnePage(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"id":1,"name":"alert-1"},{"id":2,"name":"alert-2"}]`))
+	}))
+	defer srv.Close()
+
+	c, err := New("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := c.NewAlertIterator(context.Background())
+
+	var got []AlertSummary
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d alerts, want 2", len(got))
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests to /api/alerts, want exactly 1 (no pagination support)", requests)
+	}
+
+	// A second pass over the exhausted iterator must not fetch again.
+	if it.Next() {
+		t.Fatal("Next() returned true after exhaustion")
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests after exhaustion, want still 1", requests)
+	}
+}
+
+func TestAlertIteratorEmptyResult(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c, err := New("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := c.NewAlertIterator(context.Background())
+	if it.Next() {
+		t.Fatal("Next() returned true for an empty result set")
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSearchIteratorPagesUntilEmptyPage(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query().Get("page"))
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`[{"uid":"a"},{"uid":"b"}]`))
+		case "2":
+			w.Write([]byte(`[{"uid":"c"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := c.NewSearchIterator(context.Background(), nil)
+
+	var uids []string
+	for it.Next() {
+		uids = append(uids, it.Value().UID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"a", "b", "c"}
+	if len(uids) != len(want) {
+		t.Fatalf("got uids %v, want %v", uids, want)
+	}
+	for i := range want {
+		if uids[i] != want[i] {
+			t.Fatalf("got uids %v, want %v", uids, want)
+		}
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("got %d requests, want 3 (two non-empty pages then one empty page to detect exhaustion)", len(requests))
+	}
+}
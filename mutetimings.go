@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// MuteTiming is a named set of time intervals during which notifications
+// are suppressed, as served by /api/v1/provisioning/mute-timings.
+type MuteTiming struct {
+	Name          string               `json:"name"`
+	TimeIntervals []MuteTimingInterval `json:"time_intervals"`
+}
+
+// MuteTimingInterval is a single entry in a MuteTiming's TimeIntervals.
+type MuteTimingInterval struct {
+	Times       []MuteTimingTimeRange `json:"times,omitempty"`
+	Weekdays    []string              `json:"weekdays,omitempty"`
+	DaysOfMonth []string              `json:"days_of_month,omitempty"`
+	Months      []string              `json:"months,omitempty"`
+	Years       []string              `json:"years,omitempty"`
+}
+
+// MuteTimingTimeRange is a start/end pair within a MuteTimingInterval.
+type MuteTimingTimeRange struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+// MuteTimings returns every provisioned mute timing.
+func (c *Client) MuteTimings() ([]MuteTiming, error) {
+	return c.MuteTimingsWithContext(context.Background())
+}
+
+// MuteTimingsWithContext returns every provisioned mute timing, aborting
+// the request if ctx is canceled.
+func (c *Client) MuteTimingsWithContext(ctx context.Context) ([]MuteTiming, error) {
+	result := make([]MuteTiming, 0)
+
+	res, err := c.doRequestCtx(ctx, "GET", "/api/v1/provisioning/mute-timings", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
+
+// CreateMuteTiming creates mt.
+func (c *Client) CreateMuteTiming(mt MuteTiming, disableProvenance bool) (*MuteTiming, error) {
+	return c.CreateMuteTimingWithContext(context.Background(), mt, disableProvenance)
+}
+
+// CreateMuteTimingWithContext creates mt, aborting the request if ctx is
+// canceled.
+func (c *Client) CreateMuteTimingWithContext(ctx context.Context, mt MuteTiming, disableProvenance bool) (*MuteTiming, error) {
+	res, err := c.doJSONRequestCtxHeaders(ctx, "POST", "/api/v1/provisioning/mute-timings", mt, headersForProvenance(disableProvenance))
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	created := &MuteTiming{}
+	err = res.BindJSON(created)
+	return created, err
+}
+
+// UpdateMuteTiming updates the mute timing identified by mt.Name.
+func (c *Client) UpdateMuteTiming(mt MuteTiming, disableProvenance bool) error {
+	return c.UpdateMuteTimingWithContext(context.Background(), mt, disableProvenance)
+}
+
+// UpdateMuteTimingWithContext updates the mute timing identified by
+// mt.Name, aborting the request if ctx is canceled.
+func (c *Client) UpdateMuteTimingWithContext(ctx context.Context, mt MuteTiming, disableProvenance bool) error {
+	path := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", mt.Name)
+	res, err := c.doJSONRequestCtxHeaders(ctx, "PUT", path, mt, headersForProvenance(disableProvenance))
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
+
+// DeleteMuteTiming deletes the mute timing identified by name.
+func (c *Client) DeleteMuteTiming(name string) error {
+	return c.DeleteMuteTimingWithContext(context.Background(), name)
+}
+
+// DeleteMuteTimingWithContext deletes the mute timing identified by name,
+// aborting the request if ctx is canceled.
+func (c *Client) DeleteMuteTimingWithContext(ctx context.Context, name string) error {
+	path := fmt.Sprintf("/api/v1/provisioning/mute-timings/%s", name)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
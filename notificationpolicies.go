@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NotificationPolicy is a node in the unified alerting notification
+// policy tree, as served by /api/v1/provisioning/policies.
+type NotificationPolicy struct {
+	Receiver          string               `json:"receiver,omitempty"`
+	GroupBy           []string             `json:"group_by,omitempty"`
+	GroupWait         string               `json:"group_wait,omitempty"`
+	GroupInterval     string               `json:"group_interval,omitempty"`
+	RepeatInterval    string               `json:"repeat_interval,omitempty"`
+	Matchers          []ObjectMatcher      `json:"object_matchers,omitempty"`
+	MuteTimeIntervals []string             `json:"mute_time_intervals,omitempty"`
+	Routes            []NotificationPolicy `json:"routes,omitempty"`
+}
+
+// ObjectMatcher is a single label matcher on a NotificationPolicy route.
+// Grafana serializes these as a 3-element [label, operator, value] array
+// rather than a JSON object, so ObjectMatcher implements its own
+// MarshalJSON/UnmarshalJSON to match that wire format.
+type ObjectMatcher struct {
+	Label    string
+	Operator string
+	Value    string
+}
+
+// MarshalJSON encodes m as the [label, operator, value] array Grafana
+// expects.
+func (m ObjectMatcher) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]string{m.Label, m.Operator, m.Value})
+}
+
+// UnmarshalJSON decodes m from the [label, operator, value] array
+// Grafana sends.
+func (m *ObjectMatcher) UnmarshalJSON(data []byte) error {
+	var tuple [3]string
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return fmt.Errorf("object matcher: %w", err)
+	}
+
+	m.Label, m.Operator, m.Value = tuple[0], tuple[1], tuple[2]
+	return nil
+}
+
+// NotificationPolicyTree returns the root of the notification policy
+// tree.
+func (c *Client) NotificationPolicyTree() (*NotificationPolicy, error) {
+	return c.NotificationPolicyTreeWithContext(context.Background())
+}
+
+// NotificationPolicyTreeWithContext returns the root of the notification
+// policy tree, aborting the request if ctx is canceled.
+func (c *Client) NotificationPolicyTreeWithContext(ctx context.Context) (*NotificationPolicy, error) {
+	res, err := c.doRequestCtx(ctx, "GET", "/api/v1/provisioning/policies", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return nil, res.Error()
+	}
+
+	tree := &NotificationPolicy{}
+	err = res.BindJSON(tree)
+	return tree, err
+}
+
+// SetNotificationPolicyTree replaces the notification policy tree with
+// tree.
+func (c *Client) SetNotificationPolicyTree(tree NotificationPolicy, disableProvenance bool) error {
+	return c.SetNotificationPolicyTreeWithContext(context.Background(), tree, disableProvenance)
+}
+
+// SetNotificationPolicyTreeWithContext replaces the notification policy
+// tree with tree, aborting the request if ctx is canceled.
+func (c *Client) SetNotificationPolicyTreeWithContext(ctx context.Context, tree NotificationPolicy, disableProvenance bool) error {
+	res, err := c.doJSONRequestCtxHeaders(ctx, "PUT", "/api/v1/provisioning/policies", tree, headersForProvenance(disableProvenance))
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}
@@ -0,0 +1,418 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProvisioningDataSource mirrors a single entry in Grafana's
+// datasources.yaml provisioning file.
+type ProvisioningDataSource struct {
+	Name      string                 `yaml:"name"`
+	Type      string                 `yaml:"type"`
+	Access    string                 `yaml:"access"`
+	URL       string                 `yaml:"url"`
+	IsDefault bool                   `yaml:"isDefault,omitempty"`
+	JSONData  map[string]interface{} `yaml:"jsonData,omitempty"`
+}
+
+type provisioningDataSourcesFile struct {
+	APIVersion  int                      `yaml:"apiVersion"`
+	Datasources []ProvisioningDataSource `yaml:"datasources"`
+}
+
+// dashboardProvider mirrors a single entry in Grafana's dashboards.yaml
+// provisioning file.
+type dashboardProvider struct {
+	Name    string                   `yaml:"name"`
+	Type    string                   `yaml:"type"`
+	Folder  string                   `yaml:"folder,omitempty"`
+	Options dashboardProviderOptions `yaml:"options"`
+}
+
+type dashboardProviderOptions struct {
+	Path string `yaml:"path"`
+}
+
+type provisioningDashboardsFile struct {
+	APIVersion int                 `yaml:"apiVersion"`
+	Providers  []dashboardProvider `yaml:"providers"`
+}
+
+const dashboardsProvisioningDir = "dashboards"
+
+// ProvisioningPlan summarizes the changes ApplyProvisioning would make (or
+// made, outside of dry-run mode).
+type ProvisioningPlan struct {
+	DataSourcesToCreate []string
+	DataSourcesToUpdate []string
+	DataSourcesToDelete []string
+	DashboardsToCreate  []string
+	DashboardsToUpdate  []string
+	AlertingToCreate    []string
+	AlertingToUpdate    []string
+}
+
+// IsEmpty reports whether the plan contains no changes.
+func (p *ProvisioningPlan) IsEmpty() bool {
+	return len(p.DataSourcesToCreate) == 0 && len(p.DataSourcesToUpdate) == 0 &&
+		len(p.DataSourcesToDelete) == 0 && len(p.DashboardsToCreate) == 0 && len(p.DashboardsToUpdate) == 0 &&
+		len(p.AlertingToCreate) == 0 && len(p.AlertingToUpdate) == 0
+}
+
+func (p *ProvisioningPlan) String() string {
+	s := ""
+	for _, name := range p.DataSourcesToCreate {
+		s += fmt.Sprintf("+ datasource %q\n", name)
+	}
+	for _, name := range p.DataSourcesToUpdate {
+		s += fmt.Sprintf("~ datasource %q\n", name)
+	}
+	for _, name := range p.DataSourcesToDelete {
+		s += fmt.Sprintf("- datasource %q\n", name)
+	}
+	for _, uid := range p.DashboardsToCreate {
+		s += fmt.Sprintf("+ dashboard %q\n", uid)
+	}
+	for _, uid := range p.DashboardsToUpdate {
+		s += fmt.Sprintf("~ dashboard %q\n", uid)
+	}
+	for _, name := range p.AlertingToCreate {
+		s += fmt.Sprintf("+ %s\n", name)
+	}
+	for _, name := range p.AlertingToUpdate {
+		s += fmt.Sprintf("~ %s\n", name)
+	}
+	return s
+}
+
+type provisioningConfig struct {
+	dryRun            bool
+	disableProvenance bool
+}
+
+// ProvisioningOption configures an ApplyProvisioning call.
+type ProvisioningOption func(*provisioningConfig)
+
+// WithDryRun causes ApplyProvisioning to compute and return a plan without
+// making any changes.
+func WithDryRun() ProvisioningOption {
+	return func(cfg *provisioningConfig) {
+		cfg.dryRun = true
+	}
+}
+
+// WithDisableProvenance causes any alerting resources ApplyProvisioning
+// creates or updates to remain editable from the Grafana UI, by setting
+// the X-Disable-Provenance header on those requests.
+func WithDisableProvenance() ProvisioningOption {
+	return func(cfg *provisioningConfig) {
+		cfg.disableProvenance = true
+	}
+}
+
+// ExportProvisioning writes the live datasources on c to
+// dir/datasources.yaml, the live dashboards to dir/dashboards/<uid>.json
+// with a dir/dashboards.yaml provider, and the live alerting resources to
+// dir/alerting/*.yaml.
+func (c *Client) ExportProvisioning(dir string) error {
+	return c.ExportProvisioningWithContext(context.Background(), dir)
+}
+
+// ExportProvisioningWithContext behaves like ExportProvisioning, aborting
+// the requests if ctx is canceled.
+func (c *Client) ExportProvisioningWithContext(ctx context.Context, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	dataSources, err := c.DataSourcesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	file := provisioningDataSourcesFile{APIVersion: 1}
+	for _, ds := range dataSources {
+		file.Datasources = append(file.Datasources, ProvisioningDataSource{
+			Name:      ds.Name,
+			Type:      ds.Type,
+			Access:    ds.Access,
+			URL:       ds.URL,
+			IsDefault: ds.IsDefault,
+			JSONData:  ds.JSONData,
+		})
+	}
+
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "datasources.yaml"), data, 0o644); err != nil {
+		return err
+	}
+
+	if err := c.exportDashboardProvisioningWithContext(ctx, dir); err != nil {
+		return err
+	}
+
+	return c.exportAlertingProvisioningWithContext(ctx, dir)
+}
+
+func (c *Client) exportDashboardProvisioningWithContext(ctx context.Context, dir string) error {
+	dashboardsDir := filepath.Join(dir, dashboardsProvisioningDir)
+	if err := os.MkdirAll(dashboardsDir, 0o755); err != nil {
+		return err
+	}
+
+	it := c.NewSearchIterator(ctx, url.Values{"type": []string{"dash-db"}})
+	for it.Next() {
+		hit := it.Value()
+
+		dashboard, err := c.DashboardByUIDWithContext(ctx, hit.UID)
+		if err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(dashboard.Model, "", "  ")
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+
+		if err := os.WriteFile(filepath.Join(dashboardsDir, hit.UID+".json"), data, 0o644); err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	providersFile := provisioningDashboardsFile{
+		APIVersion: 1,
+		Providers: []dashboardProvider{{
+			Name:    "default",
+			Type:    "file",
+			Options: dashboardProviderOptions{Path: dashboardsProvisioningDir},
+		}},
+	}
+
+	providersData, err := yaml.Marshal(providersFile)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "dashboards.yaml"), providersData, 0o644)
+}
+
+// ApplyProvisioning reconciles dir/datasources.yaml against the live
+// datasources on c, creating, updating, and deleting as needed. It also
+// creates or updates the dashboards under dir/dashboards and the alerting
+// resources in dir/alerting/*.yaml; dashboards and alerting resources that
+// are not in dir are left in place. With WithDryRun, no changes are made
+// and the computed plan is returned as-is.
+func (c *Client) ApplyProvisioning(dir string, opts ...ProvisioningOption) (*ProvisioningPlan, error) {
+	return c.ApplyProvisioningWithContext(context.Background(), dir, opts...)
+}
+
+// ApplyProvisioningWithContext behaves like ApplyProvisioning, aborting
+// the requests if ctx is canceled.
+func (c *Client) ApplyProvisioningWithContext(ctx context.Context, dir string, opts ...ProvisioningOption) (*ProvisioningPlan, error) {
+	cfg := &provisioningConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "datasources.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	var desiredFile provisioningDataSourcesFile
+	if err := yaml.Unmarshal(data, &desiredFile); err != nil {
+		return nil, err
+	}
+
+	desired := make(map[string]ProvisioningDataSource, len(desiredFile.Datasources))
+	for _, ds := range desiredFile.Datasources {
+		desired[ds.Name] = ds
+	}
+
+	actualList, err := c.DataSourcesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := make(map[string]DataSource, len(actualList))
+	for _, ds := range actualList {
+		actual[ds.Name] = ds
+	}
+
+	plan := &ProvisioningPlan{}
+	for name, want := range desired {
+		have, ok := actual[name]
+		if !ok {
+			plan.DataSourcesToCreate = append(plan.DataSourcesToCreate, name)
+		} else if !dataSourceUpToDate(have, want) {
+			plan.DataSourcesToUpdate = append(plan.DataSourcesToUpdate, name)
+		}
+	}
+	for name := range actual {
+		if _, ok := desired[name]; !ok {
+			plan.DataSourcesToDelete = append(plan.DataSourcesToDelete, name)
+		}
+	}
+
+	dashboardPlan, desiredDashboards, err := c.dashboardProvisioningPlan(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	plan.DashboardsToCreate = dashboardPlan.DashboardsToCreate
+	plan.DashboardsToUpdate = dashboardPlan.DashboardsToUpdate
+
+	if cfg.dryRun {
+		alertingPlan, err := c.applyAlertingProvisioningWithContext(ctx, dir, true, cfg.disableProvenance)
+		if err != nil {
+			return nil, err
+		}
+		plan.AlertingToCreate = alertingPlan.AlertingToCreate
+		plan.AlertingToUpdate = alertingPlan.AlertingToUpdate
+		return plan, nil
+	}
+
+	for _, uid := range append(append([]string{}, plan.DashboardsToCreate...), plan.DashboardsToUpdate...) {
+		dashboard := desiredDashboards[uid]
+		dashboard.Overwrite = true
+		if _, err := c.SaveDashboardWithContext(ctx, dashboard); err != nil {
+			return plan, err
+		}
+	}
+
+	for _, name := range plan.DataSourcesToCreate {
+		want := desired[name]
+		if _, err := c.CreateDataSourceWithContext(ctx, toDataSource(want)); err != nil {
+			return plan, err
+		}
+	}
+	for _, name := range plan.DataSourcesToUpdate {
+		want := toDataSource(desired[name])
+		want.ID = actual[name].ID
+		if err := c.UpdateDataSourceWithContext(ctx, want); err != nil {
+			return plan, err
+		}
+	}
+	for _, name := range plan.DataSourcesToDelete {
+		if err := c.DeleteDataSourceWithContext(ctx, actual[name].ID); err != nil {
+			return plan, err
+		}
+	}
+
+	alertingPlan, err := c.applyAlertingProvisioningWithContext(ctx, dir, false, cfg.disableProvenance)
+	if err != nil {
+		return plan, err
+	}
+	plan.AlertingToCreate = alertingPlan.AlertingToCreate
+	plan.AlertingToUpdate = alertingPlan.AlertingToUpdate
+
+	return plan, nil
+}
+
+// dashboardProvisioningPlan compares the dashboards under
+// dir/dashboards against what's live and reports which are new
+// (DashboardsToCreate) and which already exist but have drifted from the
+// file (DashboardsToUpdate). It also returns the desired Dashboard for each
+// UID so the caller can save it.
+func (c *Client) dashboardProvisioningPlan(ctx context.Context, dir string) (*ProvisioningPlan, map[string]Dashboard, error) {
+	dashboardsDir := filepath.Join(dir, dashboardsProvisioningDir)
+	entries, err := os.ReadDir(dashboardsDir)
+	if os.IsNotExist(err) {
+		return &ProvisioningPlan{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan := &ProvisioningPlan{}
+	desired := make(map[string]Dashboard)
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		uid := strings.TrimSuffix(entry.Name(), ".json")
+
+		data, err := os.ReadFile(filepath.Join(dashboardsDir, entry.Name()))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var model map[string]interface{}
+		if err := json.Unmarshal(data, &model); err != nil {
+			return nil, nil, err
+		}
+		model["uid"] = uid
+
+		desired[uid] = Dashboard{Model: model}
+
+		have, err := c.DashboardByUIDWithContext(ctx, uid)
+		switch {
+		case IsNotFound(err):
+			plan.DashboardsToCreate = append(plan.DashboardsToCreate, uid)
+		case err != nil:
+			return nil, nil, err
+		case !dashboardModelUpToDate(have.Model, model):
+			plan.DashboardsToUpdate = append(plan.DashboardsToUpdate, uid)
+		}
+	}
+
+	return plan, desired, nil
+}
+
+// dashboardModelUpToDate reports whether have already matches want,
+// ignoring fields Grafana itself assigns (id and version) so that an
+// unchanged dashboard doesn't look like drift.
+func dashboardModelUpToDate(have, want map[string]interface{}) bool {
+	return reflect.DeepEqual(stripGrafanaManagedFields(have), stripGrafanaManagedFields(want))
+}
+
+func stripGrafanaManagedFields(model map[string]interface{}) map[string]interface{} {
+	stripped := make(map[string]interface{}, len(model))
+	for k, v := range model {
+		if k == "id" || k == "version" {
+			continue
+		}
+		stripped[k] = v
+	}
+	return stripped
+}
+
+// dataSourceUpToDate reports whether have already matches the
+// provisioning file's desired state for it, so ApplyProvisioning can skip
+// a needless PUT.
+func dataSourceUpToDate(have DataSource, want ProvisioningDataSource) bool {
+	return have.Type == want.Type &&
+		have.Access == want.Access &&
+		have.URL == want.URL &&
+		have.IsDefault == want.IsDefault &&
+		reflect.DeepEqual(have.JSONData, want.JSONData)
+}
+
+func toDataSource(p ProvisioningDataSource) DataSource {
+	return DataSource{
+		Name:      p.Name,
+		Type:      p.Type,
+		Access:    p.Access,
+		URL:       p.URL,
+		IsDefault: p.IsDefault,
+		JSONData:  p.JSONData,
+	}
+}
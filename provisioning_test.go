@@ -0,0 +1,100 @@
+package gapi
+
+import "testing"
+
+func TestDataSourceUpToDate(t *testing.T) {
+	want := ProvisioningDataSource{
+		Name:      "prometheus",
+		Type:      "prometheus",
+		Access:    "proxy",
+		URL:       "http://prometheus:9090",
+		IsDefault: true,
+		JSONData:  map[string]interface{}{"timeInterval": "15s"},
+	}
+
+	upToDate := DataSource{
+		Type:      "prometheus",
+		Access:    "proxy",
+		URL:       "http://prometheus:9090",
+		IsDefault: true,
+		JSONData:  map[string]interface{}{"timeInterval": "15s"},
+	}
+	if !dataSourceUpToDate(upToDate, want) {
+		t.Error("dataSourceUpToDate(upToDate, want) = false, want true")
+	}
+
+	drifted := upToDate
+	drifted.URL = "http://prometheus:9091"
+	if dataSourceUpToDate(drifted, want) {
+		t.Error("dataSourceUpToDate(drifted, want) = true, want false")
+	}
+}
+
+func TestDashboardModelUpToDate(t *testing.T) {
+	have := map[string]interface{}{
+		"id":      float64(42),
+		"version": float64(3),
+		"title":   "My Dashboard",
+		"uid":     "abc",
+	}
+	want := map[string]interface{}{
+		"title": "My Dashboard",
+		"uid":   "abc",
+	}
+	if !dashboardModelUpToDate(have, want) {
+		t.Error("dashboardModelUpToDate should ignore id/version and treat this as unchanged")
+	}
+
+	want["title"] = "A Different Title"
+	if dashboardModelUpToDate(have, want) {
+		t.Error("dashboardModelUpToDate should detect a drifted title")
+	}
+}
+
+func TestContactPointUpToDate(t *testing.T) {
+	have := ContactPoint{
+		Type:                  "email",
+		DisableResolveMessage: false,
+		Settings:              map[string]interface{}{"addresses": "a@example.com"},
+	}
+	want := have
+	if !contactPointUpToDate(have, want) {
+		t.Error("contactPointUpToDate(have, want) = false, want true for identical contact points")
+	}
+
+	want.Settings = map[string]interface{}{"addresses": "b@example.com"}
+	if contactPointUpToDate(have, want) {
+		t.Error("contactPointUpToDate should detect a drifted setting")
+	}
+}
+
+func TestGroupAlertRules(t *testing.T) {
+	rules := []AlertRule{
+		{UID: "r1", FolderUID: "folder-a", RuleGroup: "group-1"},
+		{UID: "r2", FolderUID: "folder-a", RuleGroup: "group-1"},
+		{UID: "r3", FolderUID: "folder-b", RuleGroup: "group-1"},
+	}
+
+	groups := groupAlertRules(rules)
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+
+	byKey := make(map[string][]AlertRule, len(groups))
+	for _, g := range groups {
+		byKey[g.FolderUID+"/"+g.Title] = g.Rules
+	}
+
+	if len(byKey["folder-a/group-1"]) != 2 {
+		t.Errorf("folder-a/group-1 got %d rules, want 2", len(byKey["folder-a/group-1"]))
+	}
+	if len(byKey["folder-b/group-1"]) != 1 {
+		t.Errorf("folder-b/group-1 got %d rules, want 1", len(byKey["folder-b/group-1"]))
+	}
+
+	for _, g := range groups {
+		if g.Interval != 0 {
+			t.Errorf("group %s/%s got Interval %d, want 0 (groupAlertRules can't know it; the caller fills it in)", g.FolderUID, g.Title, g.Interval)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a simple token-bucket limiter used to cap the rate of
+// outgoing requests made by a Client.
+type rateLimiter struct {
+	mu         sync.Mutex
+	rps        float64
+	burst      int
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rps:        rps,
+		burst:      burst,
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := r.reserve()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve refills the bucket and either takes a token (returning 0) or
+// returns how long the caller should wait before trying again.
+func (r *rateLimiter) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(r.lastRefill).Seconds()
+	r.lastRefill = now
+
+	r.tokens += elapsed * r.rps
+	if r.tokens > float64(r.burst) {
+		r.tokens = float64(r.burst)
+	}
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+
+	missing := 1 - r.tokens
+	wait := time.Duration(missing / r.rps * float64(time.Second))
+	if wait <= 0 {
+		wait = time.Nanosecond
+	}
+	return wait
+}
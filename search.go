@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+)
+
+// SearchResult represents a single hit returned by the /api/search
+// endpoint, covering both dashboards and folders.
+type SearchResult struct {
+	ID          int64    `json:"id"`
+	UID         string   `json:"uid"`
+	Title       string   `json:"title"`
+	URI         string   `json:"uri"`
+	URL         string   `json:"url"`
+	Slug        string   `json:"slug"`
+	Type        string   `json:"type"`
+	FolderID    int64    `json:"folderId"`
+	FolderUID   string   `json:"folderUid"`
+	FolderTitle string   `json:"folderTitle"`
+	Tags        []string `json:"tags"`
+}
+
+// Search queries /api/search with the given query parameters, e.g.
+// url.Values{"type": []string{"dash-db"}}.
+func (c *Client) Search(params url.Values) ([]SearchResult, error) {
+	return c.SearchWithContext(context.Background(), params)
+}
+
+// SearchWithContext queries /api/search, aborting the request if ctx is
+// canceled.
+func (c *Client) SearchWithContext(ctx context.Context, params url.Values) ([]SearchResult, error) {
+	result := make([]SearchResult, 0)
+
+	path := "/api/search"
+	if encoded := params.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+
+	res, err := c.doRequestCtx(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// SearchIterator streams SearchResult hits page by page, decoding each
+// page element-by-element rather than buffering the full result set. This
+// matters on instances with tens of thousands of dashboards.
+type SearchIterator struct {
+	client    *Client
+	ctx       context.Context
+	params    url.Values
+	pageSize  int
+	page      int
+	dec       *json.Decoder
+	cur       SearchResult
+	err       error
+	exhausted bool
+}
+
+// NewSearchIterator returns a SearchIterator that pages through
+// /api/search using the given query parameters (e.g. url.Values{"type":
+// []string{"dash-db"}}). Any "limit" or "page" values in params are
+// overwritten as the iterator pages through results.
+func (c *Client) NewSearchIterator(ctx context.Context, params url.Values) *SearchIterator {
+	if params == nil {
+		params = url.Values{}
+	}
+
+	return &SearchIterator{
+		client:   c,
+		ctx:      ctx,
+		params:   params,
+		pageSize: 100,
+	}
+}
+
+// Next advances the iterator and reports whether a value is available via
+// Value. It returns false once the result set is exhausted or an error
+// occurs; use Err to distinguish the two.
+func (it *SearchIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for {
+		if it.dec != nil && it.dec.More() {
+			if err := it.dec.Decode(&it.cur); err != nil {
+				it.err = err
+				return false
+			}
+			return true
+		}
+
+		if it.exhausted {
+			return false
+		}
+
+		if !it.fetchPage() {
+			return false
+		}
+	}
+}
+
+func (it *SearchIterator) fetchPage() bool {
+	params := url.Values{}
+	for k, v := range it.params {
+		params[k] = v
+	}
+	params.Set("limit", strconv.Itoa(it.pageSize))
+	params.Set("page", strconv.Itoa(it.page+1))
+
+	res, err := it.client.doRequestCtx(it.ctx, "GET", "/api/search?"+params.Encode(), nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if !res.OK() {
+		it.err = res.Error()
+		return false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(res.data))
+	if _, err := dec.Token(); err != nil { // consume the opening '['
+		it.err = err
+		return false
+	}
+
+	if !dec.More() {
+		it.exhausted = true
+		return false
+	}
+
+	it.dec = dec
+	it.page++
+	return true
+}
+
+// Value returns the search hit most recently produced by Next.
+func (it *SearchIterator) Value() SearchResult {
+	return it.cur
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
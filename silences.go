@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+)
+
+const silencesPath = "/api/alertmanager/grafana/api/v2/silences"
+
+// Silence temporarily mutes alert notifications matching its Matchers, as
+// served by the Alertmanager-compatible /api/alertmanager/grafana
+// endpoints.
+type Silence struct {
+	ID        string           `json:"id,omitempty"`
+	Matchers  []SilenceMatcher `json:"matchers"`
+	StartsAt  string           `json:"startsAt"`
+	EndsAt    string           `json:"endsAt"`
+	CreatedBy string           `json:"createdBy"`
+	Comment   string           `json:"comment"`
+}
+
+// SilenceMatcher is a single label matcher on a Silence.
+type SilenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// Silences returns every active and expired silence.
+func (c *Client) Silences() ([]Silence, error) {
+	return c.SilencesWithContext(context.Background())
+}
+
+// SilencesWithContext returns every active and expired silence, aborting
+// the request if ctx is canceled.
+func (c *Client) SilencesWithContext(ctx context.Context) ([]Silence, error) {
+	result := make([]Silence, 0)
+
+	res, err := c.doRequestCtx(ctx, "GET", silencesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.OK() {
+		return result, res.Error()
+	}
+
+	err = res.BindJSON(&result)
+	return result, err
+}
+
+// CreateSilence creates silence and returns its generated ID. To update an
+// existing silence, set silence.ID before calling CreateSilence.
+func (c *Client) CreateSilence(silence Silence) (string, error) {
+	return c.CreateSilenceWithContext(context.Background(), silence)
+}
+
+// CreateSilenceWithContext creates silence, aborting the request if ctx is
+// canceled.
+func (c *Client) CreateSilenceWithContext(ctx context.Context, silence Silence) (string, error) {
+	res, err := c.doJSONRequestCtx(ctx, "POST", silencesPath, silence)
+	if err != nil {
+		return "", err
+	}
+
+	if !res.OK() {
+		return "", res.Error()
+	}
+
+	created := struct {
+		SilenceID string `json:"silenceID"`
+	}{}
+	err = res.BindJSON(&created)
+	return created.SilenceID, err
+}
+
+// DeleteSilence expires the silence identified by id.
+func (c *Client) DeleteSilence(id string) error {
+	return c.DeleteSilenceWithContext(context.Background(), id)
+}
+
+// DeleteSilenceWithContext expires the silence identified by id, aborting
+// the request if ctx is canceled.
+func (c *Client) DeleteSilenceWithContext(ctx context.Context, id string) error {
+	path := fmt.Sprintf("%s/%s", silencesPath, id)
+	res, err := c.doRequestCtx(ctx, "DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+
+	if !res.OK() {
+		return res.Error()
+	}
+
+	return nil
+}